@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"runtime"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &Argon2EphemeralResource{}
+
+func NewArgon2EphemeralResource() ephemeral.EphemeralResource {
+	return &Argon2EphemeralResource{}
+}
+
+// Argon2EphemeralResource computes an Argon2 digest without ever writing the
+// plaintext password or the resulting hash to state.
+type Argon2EphemeralResource struct{}
+
+// Argon2EphemeralResourceModel describes the ephemeral resource data model.
+type Argon2EphemeralResourceModel struct {
+	Salt     types.String `tfsdk:"salt"`
+	Password types.String `tfsdk:"password"`
+	Variant  types.String `tfsdk:"variant"`
+	KeyLen   types.Int32  `tfsdk:"key_len"`
+	Time     types.Int32  `tfsdk:"time"`
+	Thread   types.Int32  `tfsdk:"thread"`
+	Memory   types.Int32  `tfsdk:"memory"`
+	Hash     types.String `tfsdk:"hash"`
+}
+
+func (r *Argon2EphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_argon2_ephemeral"
+}
+
+func (r *Argon2EphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Computes an Argon2 digest for the duration of a single plan or apply, without persisting the plaintext password or the hash to state.",
+		Attributes: map[string]schema.Attribute{
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password to hash",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"salt": schema.StringAttribute{
+				MarkdownDescription: "The salt to use for hashing. Must be at least 8 bytes long",
+				Required:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(minSaltLen),
+				},
+			},
+			"variant": schema.StringAttribute{
+				MarkdownDescription: "The Argon2 variant to use: one of `argon2id`, `argon2i`, `argon2d`",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("argon2id", "argon2i", "argon2d"),
+				},
+			},
+			"key_len": schema.Int32Attribute{
+				MarkdownDescription: "The length of the key to generate",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(4),
+				},
+			},
+			"time": schema.Int32Attribute{
+				MarkdownDescription: "The number of iterations to use",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"thread": schema.Int32Attribute{
+				MarkdownDescription: "The number of threads to use",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"memory": schema.Int32Attribute{
+				MarkdownDescription: "The amount of memory to use for hashing, in KiB",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(8),
+				},
+			},
+			"hash": schema.StringAttribute{
+				MarkdownDescription: "The generated hash",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *Argon2EphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data Argon2EphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Variant.IsNull() {
+		data.Variant = types.StringValue("argon2id")
+	}
+	if data.KeyLen.IsNull() {
+		data.KeyLen = types.Int32Value(32)
+	}
+	if data.Time.IsNull() {
+		data.Time = types.Int32Value(1)
+	}
+	if data.Thread.IsNull() {
+		data.Thread = types.Int32Value(int32(runtime.NumCPU()))
+	}
+	if data.Memory.IsNull() {
+		data.Memory = types.Int32Value(65536)
+	}
+
+	digest := generatePassword(&resp.Diagnostics, Argon2ResourceModel{
+		Salt:     data.Salt,
+		Password: data.Password,
+		Variant:  data.Variant,
+		KeyLen:   data.KeyLen,
+		Time:     data.Time,
+		Thread:   data.Thread,
+		Memory:   data.Memory,
+	})
+	if digest == nil {
+		return
+	}
+	data.Hash = types.StringValue(digest.String())
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
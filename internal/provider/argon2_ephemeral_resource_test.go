@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccArgon2EphemeralResourceOpen(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"password": providerserver.NewProtocol6WithError(New("test")()),
+			"echo":     echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+ephemeral "password_argon2_ephemeral" "test" {
+  password = "example-password"
+  salt     = "example-salt"
+}
+
+provider "echo" {
+  data = ephemeral.password_argon2_ephemeral.test
+}
+
+resource "echo" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("echo.test", "data.hash", validatePassword("example-password")),
+				),
+			},
+		},
+	})
+}
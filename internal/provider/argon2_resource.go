@@ -8,20 +8,31 @@ import (
 	"fmt"
 	"net/http"
 	"runtime"
+	"strconv"
 
 	"github.com/go-crypt/crypt/algorithm"
 	"github.com/go-crypt/crypt/algorithm/argon2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// minSaltLen is the smallest salt size, in bytes, that argon2 accepts per
+// RFC 9106 §4.
+const minSaltLen = 8
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &Argon2Resource{}
@@ -39,15 +50,19 @@ type Argon2Resource struct {
 
 // Argon2ResourceModel describes the resource data model.
 type Argon2ResourceModel struct {
-	Salt       types.String `tfsdk:"salt"`
-	Password   types.String `tfsdk:"password"`
-	KeyLen     types.Int32  `tfsdk:"key_len"`
-	Time       types.Int32  `tfsdk:"time"`
-	Thread     types.Int32  `tfsdk:"thread"`
-	Memory     types.Int32  `tfsdk:"memory"`
-	Iterations types.Int32  `tfsdk:"iterations"`
-	Hash       types.String `tfsdk:"hash"`
-	Id         types.String `tfsdk:"id"`
+	Salt                types.String `tfsdk:"salt"`
+	SaltWoVersion       types.Int32  `tfsdk:"salt_wo_version"`
+	Password            types.String `tfsdk:"password"`
+	PasswordWoVersion   types.Int32  `tfsdk:"password_wo_version"`
+	Variant             types.String `tfsdk:"variant"`
+	KeyLen              types.Int32  `tfsdk:"key_len"`
+	Time                types.Int32  `tfsdk:"time"`
+	Thread              types.Int32  `tfsdk:"thread"`
+	Memory              types.Int32  `tfsdk:"memory"`
+	RehashOnParamChange types.Bool   `tfsdk:"rehash_on_param_change"`
+	NeedsRehash         types.Bool   `tfsdk:"needs_rehash"`
+	Hash                types.String `tfsdk:"hash"`
+	Id                  types.String `tfsdk:"id"`
 }
 
 func (r *Argon2Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -60,46 +75,107 @@ func (r *Argon2Resource) Schema(ctx context.Context, req resource.SchemaRequest,
 		MarkdownDescription: "Argon2 resource",
 		Attributes: map[string]schema.Attribute{
 			"password": schema.StringAttribute{
-				MarkdownDescription: "The password to hash",
+				MarkdownDescription: "The password to hash. Write-only: never persisted to state. Requires a Terraform core that supports write-only attributes (1.11+)",
 				Required:            true,
 				Computed:            false,
 				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"password_wo_version": schema.Int32Attribute{
+				MarkdownDescription: "An arbitrary value that, when changed, signals that `password` has changed and the hash should be recomputed. Required because write-only values cannot be diffed directly",
+				Optional:            true,
 			},
 			"salt": schema.StringAttribute{
-				MarkdownDescription: "The salt to use for hashing",
+				MarkdownDescription: "The salt to use for hashing. Must be at least 8 bytes long. Write-only: never persisted to state",
 				Required:            true,
 				Computed:            false,
 				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(minSaltLen),
+				},
+			},
+			"salt_wo_version": schema.Int32Attribute{
+				MarkdownDescription: "An arbitrary value that, when changed, signals that `salt` has changed and the hash should be recomputed. Required because write-only values cannot be diffed directly",
+				Optional:            true,
+			},
+			"variant": schema.StringAttribute{
+				MarkdownDescription: "The Argon2 variant to use: one of `argon2id`, `argon2i`, `argon2d`",
+				Default:             stringdefault.StaticString("argon2id"),
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("argon2id", "argon2i", "argon2d"),
+				},
 			},
 			"key_len": schema.Int32Attribute{
 				MarkdownDescription: "The length of the key to generate",
 				Default:             int32default.StaticInt32(32),
 				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(4),
+				},
 			},
 			"time": schema.Int32Attribute{
 				MarkdownDescription: "The number of iterations to use",
 				Default:             int32default.StaticInt32(1),
 				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
 			},
 			"thread": schema.Int32Attribute{
 				MarkdownDescription: "The number of threads to use",
 				Default:             int32default.StaticInt32(int32(runtime.NumCPU())),
 				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
 			},
 			"memory": schema.Int32Attribute{
-				MarkdownDescription: "The amount of memory to use for hashing",
+				MarkdownDescription: "The amount of memory to use for hashing, in KiB",
 				Default:             int32default.StaticInt32(65536),
 				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(8),
+				},
 			},
-			"iterations": schema.Int32Attribute{
-				MarkdownDescription: "Controls the number of iterations",
-				Default:             int32default.StaticInt32(3),
+			"rehash_on_param_change": schema.BoolAttribute{
+				MarkdownDescription: "Whether to force replacement when the stored hash was computed with weaker parameters than are currently configured, instead of only warning about it",
+				Default:             booldefault.StaticBool(false),
+				Computed:            true,
+				Optional:            true,
+			},
+			"needs_rehash": schema.BoolAttribute{
+				MarkdownDescription: "Whether the stored hash was computed with weaker Argon2 parameters than are currently configured",
 				Computed:            true,
 			},
 			"hash": schema.StringAttribute{
 				MarkdownDescription: "The generated hash",
 				Computed:            true,
 				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					argon2RehashPlanModifier{},
+				},
 			},
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -142,15 +218,24 @@ func (r *Argon2Resource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	// password and salt are write-only: the plan (and state) always carry
+	// them as null, so the actual values must come from config instead.
+	resp.Diagnostics.Append(readWriteOnlyArgon2Fields(ctx, req.Config, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// For the purposes of this Argon2 code, hardcoding a response value to
 	// save into the Terraform state.
 	data.Id = types.StringValue("argon2-id")
 
-	digest := generatePassword(resp.Diagnostics, data)
+	digest := generatePassword(&resp.Diagnostics, data)
 	if digest == nil {
 		return
 	}
 	data.Hash = types.StringValue(digest.String())
+	data.NeedsRehash = types.BoolValue(false)
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
@@ -160,20 +245,64 @@ func (r *Argon2Resource) Create(ctx context.Context, req resource.CreateRequest,
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func generatePassword(diag diag.Diagnostics, data Argon2ResourceModel) algorithm.Digest {
+// argon2Variant maps the variant attribute onto the argon2.Variant the
+// go-crypt hasher expects.
+func argon2Variant(name string) (argon2.Variant, error) {
+	switch name {
+	case "argon2id":
+		return argon2.VariantID, nil
+	case "argon2i":
+		return argon2.VariantI, nil
+	case "argon2d":
+		return argon2.VariantD, nil
+	default:
+		return argon2.VariantNone, fmt.Errorf("unsupported argon2 variant %q", name)
+	}
+}
+
+// readWriteOnlyArgon2Fields copies password and salt from config into data.
+// Both are write-only, so req.Plan and req.State always carry them as null;
+// only req.Config still has the value the caller supplied.
+func readWriteOnlyArgon2Fields(ctx context.Context, config tfsdk.Config, data *Argon2ResourceModel) diag.Diagnostics {
+	var configData Argon2ResourceModel
+
+	diags := config.Get(ctx, &configData)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Password = configData.Password
+	data.Salt = configData.Salt
+
+	return diags
+}
+
+func generatePassword(diags *diag.Diagnostics, data Argon2ResourceModel) algorithm.Digest {
 	var (
 		hasher *argon2.Hasher
 		err    error
 		digest algorithm.Digest
 	)
 
-	if hasher, err = argon2.New(argon2.WithProfileRFC9106Recommended()); err != nil {
-		diag.AddError("Argon 2 error", fmt.Sprintf("Unable to create Argon2, got error: %s", err))
+	variant, err := argon2Variant(data.Variant.ValueString())
+	if err != nil {
+		diags.AddError("Argon 2 error", fmt.Sprintf("Unable to create Argon2, got error: %s", err))
+		return nil
+	}
+
+	if hasher, err = argon2.New(
+		argon2.WithVariant(variant),
+		argon2.WithK(uint32(data.KeyLen.ValueInt32())),
+		argon2.WithT(uint32(data.Time.ValueInt32())),
+		argon2.WithM(uint32(data.Memory.ValueInt32())),
+		argon2.WithP(uint8(data.Thread.ValueInt32())),
+	); err != nil {
+		diags.AddError("Argon 2 error", fmt.Sprintf("Unable to create Argon2, got error: %s", err))
 		return nil
 	}
 
-	if digest, err = hasher.Hash(data.Password.ValueString()); err != nil {
-		diag.AddError("Argon 2 error", fmt.Sprintf("Unable to hash Argon2, got error: %s", err))
+	if digest, err = hasher.HashWithSalt(data.Password.ValueString(), []byte(data.Salt.ValueString())); err != nil {
+		diags.AddError("Argon 2 error", fmt.Sprintf("Unable to hash Argon2, got error: %s", err))
 		return nil
 	}
 	return digest
@@ -197,6 +326,18 @@ func (r *Argon2Resource) Read(ctx context.Context, req resource.ReadRequest, res
 	//     return
 	// }
 
+	// needs_rehash was already computed, against the planned cost params, the
+	// last time Create or Update ran; Read has no plan to compare against, so
+	// it only surfaces the warning for whatever state already recorded
+	// instead of recomputing (which could only ever compare the hash against
+	// the very params that produced it).
+	if data.NeedsRehash.ValueBool() {
+		resp.Diagnostics.AddWarning(
+			"Argon2 hash parameters are stale",
+			fmt.Sprintf("The hash stored for %q was computed with weaker Argon2 parameters than are currently configured. Rotate the password, or set rehash_on_param_change to true, to bring it up to date.", data.Id.ValueString()),
+		)
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -215,14 +356,39 @@ func (r *Argon2Resource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 	resp.Diagnostics.Append(req.State.Get(ctx, &oldData)...)
 
-	if oldData.Password.Equal(data.Password) {
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// password and salt are write-only: the plan (and state) always carry
+	// them as null, so the actual values must come from config instead.
+	resp.Diagnostics.Append(readWriteOnlyArgon2Fields(ctx, req.Config, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// password and salt are write-only and therefore never present in prior
+	// state, so their _wo_version counterparts are what tell us whether the
+	// caller rotated either one.
+	if oldData.PasswordWoVersion.Equal(data.PasswordWoVersion) && oldData.SaltWoVersion.Equal(data.SaltWoVersion) {
 		data.Hash = oldData.Hash
+
+		// Compare the hash already on disk against the cost params the plan
+		// now calls for, not the ones that produced that hash.
+		needsRehash, diags := argon2NeedsRehash(oldData.Hash, data.Memory, data.Time, data.Thread)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.NeedsRehash = types.BoolValue(needsRehash)
 	} else {
-		digest := generatePassword(resp.Diagnostics, data)
+		digest := generatePassword(&resp.Diagnostics, data)
 		if digest == nil {
 			return
 		}
 		data.Hash = types.StringValue(digest.String())
+		data.NeedsRehash = types.BoolValue(false)
 	}
 
 	// Save updated data into Terraform state
@@ -241,5 +407,89 @@ func (r *Argon2Resource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *Argon2Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByID(ctx, req, resp)
+}
+
+// argon2NeedsRehash reports whether hash was computed with weaker Argon2
+// parameters (memory, time, threads) than memory/time/thread call for, by
+// decoding the cost parameters PHC-encodes directly into the hash. Callers
+// must pass the configured params being planned or applied, not the ones
+// that produced hash, or the comparison is trivially always false.
+func argon2NeedsRehash(hash types.String, memory, time, thread types.Int32) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if hash.IsNull() || hash.ValueString() == "" {
+		return false, diags
+	}
+
+	_, _, params, err := decodePHC(hash.ValueString())
+	if err != nil {
+		diags.AddError("Argon 2 error", fmt.Sprintf("Unable to decode stored hash, got error: %s", err))
+		return false, diags
+	}
+
+	if weaker, ok := argon2ParamIsWeaker(params["m"], memory.ValueInt32()); ok && weaker {
+		return true, diags
+	}
+	if weaker, ok := argon2ParamIsWeaker(params["t"], time.ValueInt32()); ok && weaker {
+		return true, diags
+	}
+	if weaker, ok := argon2ParamIsWeaker(params["p"], thread.ValueInt32()); ok && weaker {
+		return true, diags
+	}
+
+	return false, diags
+}
+
+// argon2ParamIsWeaker compares a cost parameter decoded from a stored hash
+// against the value currently configured. ok is false when the parameter is
+// missing or unparseable, in which case the comparison is skipped rather
+// than treated as drift.
+func argon2ParamIsWeaker(stored string, configured int32) (weaker bool, ok bool) {
+	value, err := strconv.Atoi(stored)
+	if err != nil {
+		return false, false
+	}
+
+	return int32(value) < configured, true
+}
+
+// argon2RehashPlanModifier forces replacement when rehash_on_param_change is
+// true and the hash stored in state was computed with weaker parameters than
+// the plan now calls for.
+type argon2RehashPlanModifier struct{}
+
+func (m argon2RehashPlanModifier) Description(ctx context.Context) string {
+	return "Requires replacement when rehash_on_param_change is true and the stored hash is weaker than the configured parameters."
+}
+
+func (m argon2RehashPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m argon2RehashPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Nothing to compare on create or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan Argon2ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || !plan.RehashOnParamChange.ValueBool() {
+		return
+	}
+
+	var state Argon2ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Compare the hash already stored in state against the cost parameters
+	// the plan now calls for, not the ones that produced that hash.
+	needsRehash, diags := argon2NeedsRehash(state.Hash, plan.Memory, plan.Time, plan.Thread)
+	resp.Diagnostics.Append(diags...)
+	if needsRehash {
+		resp.RequiresReplace = true
+	}
 }
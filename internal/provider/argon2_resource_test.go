@@ -8,9 +8,30 @@ import (
 	"testing"
 
 	"github.com/go-crypt/crypt"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
+func TestArgon2NeedsRehash(t *testing.T) {
+	hash := types.StringValue("$argon2id$v=19$m=65536,t=1,p=4$I2DQxFwVshVUx6voREPbMA$UFtrP8BcQQI5KJYAV5JUb42mxrIgeAuPAh9inDdiT5w")
+
+	needsRehash, diags := argon2NeedsRehash(hash, types.Int32Value(131072), types.Int32Value(1), types.Int32Value(4))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if !needsRehash {
+		t.Fatal("expected needs_rehash to be true when the configured memory is higher than the stored hash's")
+	}
+
+	needsRehash, diags = argon2NeedsRehash(hash, types.Int32Value(65536), types.Int32Value(1), types.Int32Value(4))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if needsRehash {
+		t.Fatal("expected needs_rehash to be false when the configured params match the stored hash's")
+	}
+}
+
 func TestAccArgon2ResourceCreate(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -18,11 +39,10 @@ func TestAccArgon2ResourceCreate(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccArgon2ResourceConfig("example-password", "example-salt"),
+				Config: testAccArgon2ResourceConfig("example-password", "example-salt", 1),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("password_argon2.test", "password", "example-password"),
-					resource.TestCheckResourceAttr("password_argon2.test", "salt", "example-salt"),
 					resource.TestCheckResourceAttr("password_argon2.test", "id", "argon2-id"),
+					resource.TestCheckResourceAttr("password_argon2.test", "needs_rehash", "false"),
 					resource.TestCheckResourceAttrSet("password_argon2.test", "hash"),
 					resource.TestCheckResourceAttrWith("password_argon2.test", "hash", validatePassword("example-password")),
 				),
@@ -46,28 +66,25 @@ func validatePassword(password string) resource.CheckResourceAttrWithFunc {
 	}
 }
 
-func TestAccArgon2ResourceHashShouldNotChangeWhenPasswordIsTheSame(t *testing.T) {
+func TestAccArgon2ResourceHashShouldNotChangeWhenPasswordWoVersionIsTheSame(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccArgon2ResourceConfig("example-password", "example-salt"),
+				Config: testAccArgon2ResourceConfig("example-password", "example-salt", 1),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("password_argon2.test", "password", "example-password"),
-					resource.TestCheckResourceAttr("password_argon2.test", "salt", "example-salt"),
 					resource.TestCheckResourceAttr("password_argon2.test", "id", "argon2-id"),
 					resource.TestCheckResourceAttrSet("password_argon2.test", "hash"),
 					resource.TestCheckResourceAttrWith("password_argon2.test", "hash", validatePassword("example-password")),
 				),
 			},
-			// Update and Read testing
+			// Read testing: password_wo_version unchanged, so the stored
+			// hash is reused even though password is never diffable.
 			{
-				Config: testAccArgon2ResourceConfig("example-password", "new-salt"),
+				Config: testAccArgon2ResourceConfig("example-password", "example-salt", 1),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("password_argon2.test", "password", "example-password"),
-					resource.TestCheckResourceAttr("password_argon2.test", "salt", "new-salt"),
 					resource.TestCheckResourceAttr("password_argon2.test", "id", "argon2-id"),
 					resource.TestCheckResourceAttrSet("password_argon2.test", "hash"),
 					resource.TestCheckResourceAttrWith("password_argon2.test", "hash", validatePassword("example-password")),
@@ -85,10 +102,8 @@ func TestAccArgon2ResourceCreateAndUpdate(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccArgon2ResourceConfig("example-password", "example-salt"),
+				Config: testAccArgon2ResourceConfig("example-password", "example-salt", 1),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("password_argon2.test", "password", "example-password"),
-					resource.TestCheckResourceAttr("password_argon2.test", "salt", "example-salt"),
 					resource.TestCheckResourceAttr("password_argon2.test", "id", "argon2-id"),
 					resource.TestCheckResourceAttrSet("password_argon2.test", "hash"),
 					resource.TestCheckResourceAttrWith("password_argon2.test", "hash", validatePassword("example-password")),
@@ -105,12 +120,11 @@ func TestAccArgon2ResourceCreateAndUpdate(t *testing.T) {
 			// 	// the upstream service, this can be removed.
 			// 	ImportStateVerifyIgnore: []string{"configurable_attribute", "defaulted"},
 			// },
-			// Update and Read testing
+			// Update and Read testing: bumping password_wo_version signals
+			// the write-only password changed.
 			{
-				Config: testAccArgon2ResourceConfig("new-password", "new-salt"),
+				Config: testAccArgon2ResourceConfig("new-password", "new-salt", 2),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("password_argon2.test", "password", "new-password"),
-					resource.TestCheckResourceAttr("password_argon2.test", "salt", "new-salt"),
 					resource.TestCheckResourceAttr("password_argon2.test", "id", "argon2-id"),
 					resource.TestCheckResourceAttrSet("password_argon2.test", "hash"),
 					resource.TestCheckResourceAttrWith("password_argon2.test", "hash", validatePassword("new-password")),
@@ -121,11 +135,12 @@ func TestAccArgon2ResourceCreateAndUpdate(t *testing.T) {
 	})
 }
 
-func testAccArgon2ResourceConfig(password string, salt string) string {
+func testAccArgon2ResourceConfig(password string, salt string, woVersion int) string {
 	return fmt.Sprintf(`
 resource "password_argon2" "test" {
-  password = %[1]q
-  salt = %[2]q
+  password             = %[1]q
+  salt                 = %[2]q
+  password_wo_version  = %[3]d
 }
-`, password, salt)
+`, password, salt, woVersion)
 }
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-crypt/crypt/algorithm"
+	"github.com/go-crypt/crypt/algorithm/bcrypt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &BcryptResource{}
+	_ resource.ResourceWithImportState = &BcryptResource{}
+)
+
+func NewBcryptResource() resource.Resource {
+	return &BcryptResource{}
+}
+
+// BcryptResource defines the resource implementation.
+type BcryptResource struct {
+	client *http.Client
+}
+
+// BcryptResourceModel describes the resource data model.
+type BcryptResourceModel struct {
+	Password types.String `tfsdk:"password"`
+	Cost     types.Int32  `tfsdk:"cost"`
+	Hash     types.String `tfsdk:"hash"`
+	Id       types.String `tfsdk:"id"`
+}
+
+func (m *BcryptResourceModel) GetPassword() types.String { return m.Password }
+func (m *BcryptResourceModel) GetHash() types.String     { return m.Hash }
+func (m *BcryptResourceModel) SetHash(hash types.String) { m.Hash = hash }
+func (m *BcryptResourceModel) SetId(id types.String)     { m.Id = id }
+
+func (r *BcryptResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bcrypt"
+}
+
+func (r *BcryptResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Bcrypt resource",
+		Attributes: map[string]schema.Attribute{
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password to hash",
+				Required:            true,
+				Computed:            false,
+				Sensitive:           true,
+			},
+			"cost": schema.Int32Attribute{
+				MarkdownDescription: "The bcrypt cost factor",
+				Default:             int32default.StaticInt32(10),
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int32{
+					int32validator.Between(4, 31),
+				},
+			},
+			"hash": schema.StringAttribute{
+				MarkdownDescription: "The generated hash",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Bcrypt identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *BcryptResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureHashClient(req, resp)
+}
+
+func bcryptHasher(data *BcryptResourceModel) (algorithm.Hash, error) {
+	return bcrypt.New(bcrypt.WithCost(int(data.Cost.ValueInt32())))
+}
+
+func (r *BcryptResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	createHashResource[BcryptResourceModel](ctx, req, resp, "bcrypt-id", bcryptHasher)
+}
+
+func (r *BcryptResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readHashResource[BcryptResourceModel](ctx, req, resp)
+}
+
+func (r *BcryptResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	updateHashResource[BcryptResourceModel](ctx, req, resp, bcryptHasher)
+}
+
+func (r *BcryptResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	deleteHashResource[BcryptResourceModel](ctx, req, resp)
+}
+
+func (r *BcryptResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateByID(ctx, req, resp)
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBcryptResourceCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBcryptResourceConfig("example-password"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("password_bcrypt.test", "password", "example-password"),
+					resource.TestCheckResourceAttr("password_bcrypt.test", "id", "bcrypt-id"),
+					resource.TestCheckResourceAttrSet("password_bcrypt.test", "hash"),
+					resource.TestCheckResourceAttrWith("password_bcrypt.test", "hash", validatePassword("example-password")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBcryptResourceCreateAndUpdate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBcryptResourceConfig("example-password"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("password_bcrypt.test", "hash", validatePassword("example-password")),
+				),
+			},
+			{
+				Config: testAccBcryptResourceConfig("new-password"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("password_bcrypt.test", "hash", validatePassword("new-password")),
+				),
+			},
+		},
+	})
+}
+
+func testAccBcryptResourceConfig(password string) string {
+	return fmt.Sprintf(`
+resource "password_bcrypt" "test" {
+  password = %[1]q
+}
+`, password)
+}
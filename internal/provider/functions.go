@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-crypt/crypt"
+	"github.com/go-crypt/crypt/algorithm/argon2"
+	"github.com/go-crypt/crypt/algorithm/bcrypt"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementations satisfy the expected interfaces.
+var (
+	_ function.Function = &Argon2IDFunction{}
+	_ function.Function = &BcryptFunction{}
+	_ function.Function = &VerifyFunction{}
+)
+
+// NewArgon2IDFunction returns the provider-defined function implementing
+// provider::password::argon2id.
+func NewArgon2IDFunction() function.Function {
+	return &Argon2IDFunction{}
+}
+
+// Argon2IDFunction hashes a password with Argon2id and returns a PHC string.
+type Argon2IDFunction struct{}
+
+func (f *Argon2IDFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "argon2id"
+}
+
+func (f *Argon2IDFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Hash a password with Argon2id",
+		MarkdownDescription: "Hashes `password` with Argon2id using the given salt and cost parameters, returning a PHC-formatted digest. The function itself writes nothing to state.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "password",
+				MarkdownDescription: "The password to hash",
+			},
+			function.StringParameter{
+				Name:                "salt",
+				MarkdownDescription: "The salt to use for hashing",
+			},
+			function.Int64Parameter{
+				Name:                "memory",
+				MarkdownDescription: "The amount of memory to use for hashing, in KiB",
+			},
+			function.Int64Parameter{
+				Name:                "time",
+				MarkdownDescription: "The number of iterations to use",
+			},
+			function.Int64Parameter{
+				Name:                "threads",
+				MarkdownDescription: "The number of threads to use",
+			},
+			function.Int64Parameter{
+				Name:                "key_len",
+				MarkdownDescription: "The length of the derived key, in bytes",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *Argon2IDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		password, salt                string
+		memory, time, threads, keyLen int64
+	)
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &password, &salt, &memory, &time, &threads, &keyLen))
+	if resp.Error != nil {
+		return
+	}
+
+	hasher, err := argon2.New(
+		argon2.WithVariant(argon2.VariantID),
+		argon2.WithM(uint32(memory)),
+		argon2.WithT(uint32(time)),
+		argon2.WithP(uint8(threads)),
+		argon2.WithK(uint32(keyLen)),
+	)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("unable to create Argon2 hasher: %s", err)))
+		return
+	}
+
+	digest, err := hasher.HashWithSalt(password, []byte(salt))
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("unable to hash password: %s", err)))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, digest.String()))
+}
+
+// NewBcryptFunction returns the provider-defined function implementing
+// provider::password::bcrypt.
+func NewBcryptFunction() function.Function {
+	return &BcryptFunction{}
+}
+
+// BcryptFunction hashes a password with bcrypt and returns a PHC string.
+type BcryptFunction struct{}
+
+func (f *BcryptFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "bcrypt"
+}
+
+func (f *BcryptFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Hash a password with bcrypt",
+		MarkdownDescription: "Hashes `password` with bcrypt at the given cost, returning a PHC-formatted digest.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "password",
+				MarkdownDescription: "The password to hash",
+			},
+			function.Int64Parameter{
+				Name:                "cost",
+				MarkdownDescription: "The bcrypt cost factor",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *BcryptFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		password string
+		cost     int64
+	)
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &password, &cost))
+	if resp.Error != nil {
+		return
+	}
+
+	hasher, err := bcrypt.New(bcrypt.WithCost(int(cost)))
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("unable to create bcrypt hasher: %s", err)))
+		return
+	}
+
+	digest, err := hasher.Hash(password)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("unable to hash password: %s", err)))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, digest.String()))
+}
+
+// NewVerifyFunction returns the provider-defined function implementing
+// provider::password::verify.
+func NewVerifyFunction() function.Function {
+	return &VerifyFunction{}
+}
+
+// VerifyFunction checks a plaintext password against a PHC-formatted hash.
+type VerifyFunction struct{}
+
+func (f *VerifyFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "verify"
+}
+
+func (f *VerifyFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Verify a password against a PHC hash",
+		MarkdownDescription: "Checks `password` against `phc_hash`, a PHC-formatted digest produced by any hasher this provider ships, and returns whether they match.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "password",
+				MarkdownDescription: "The password to check",
+			},
+			function.StringParameter{
+				Name:                "phc_hash",
+				MarkdownDescription: "The PHC-formatted hash to check the password against",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *VerifyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var password, phcHash string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &password, &phcHash))
+	if resp.Error != nil {
+		return
+	}
+
+	valid, err := crypt.CheckPassword(password, phcHash)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("unable to verify password: %s", err)))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, valid))
+}
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-crypt/crypt/algorithm"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// hashResourceModel is implemented by the *ResourceModel of every
+// password_* resource that only ever rehashes on a plain password change
+// (i.e. every algorithm but Argon2, whose write-only attributes and
+// rehash-on-param-change give it its own Create/Read/Update/Delete and which
+// does not implement this interface). Implementing it is what lets
+// Create, Read, Update, Delete and Configure live once, in this file,
+// instead of once per algorithm: adding a new algorithm resource is then a
+// matter of writing its Metadata/Schema/ImportState and a newHasher
+// closure.
+type hashResourceModel[T any] interface {
+	*T
+	GetPassword() types.String
+	GetHash() types.String
+	SetHash(types.String)
+	SetId(types.String)
+}
+
+// newDigest builds the hasher returned by newHasher and uses it to hash
+// password, appending any failure to diags. It returns nil when either step
+// fails, so callers can bail out after a single nil check the same way
+// generatePassword already does for Argon2.
+//
+// newHasher is the "algorithm.Hash factory" every password_* resource but
+// Argon2 supplies: a closure over that resource's own cost parameters,
+// returning one of bcrypt.New, scrypt.New, shacrypt.New or pbkdf2.New.
+func newDigest(diags *diag.Diagnostics, newHasher func() (algorithm.Hash, error), password string) algorithm.Digest {
+	hasher, err := newHasher()
+	if err != nil {
+		diags.AddError("Hash error", fmt.Sprintf("Unable to create hasher, got error: %s", err))
+		return nil
+	}
+
+	digest, err := hasher.Hash(password)
+	if err != nil {
+		diags.AddError("Hash error", fmt.Sprintf("Unable to hash password, got error: %s", err))
+		return nil
+	}
+
+	return digest
+}
+
+// configureHashClient is the Configure implementation shared by every
+// password hashing resource: each stores the *http.Client handed back by
+// the provider and reports a diagnostic if the provider handed back
+// something else.
+func configureHashClient(req resource.ConfigureRequest, resp *resource.ConfigureResponse) *http.Client {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return nil
+	}
+
+	client, ok := req.ProviderData.(*http.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return nil
+	}
+
+	return client
+}
+
+// createHashResource is the Create implementation shared by every
+// password hashing resource except Argon2: read the plan, hash the
+// password with newHasher and save it under the given synthetic id.
+func createHashResource[T any, PT hashResourceModel[T]](ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse, id string, newHasher func(PT) (algorithm.Hash, error)) {
+	data := PT(new(T))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.SetId(types.StringValue(id))
+
+	digest := newDigest(&resp.Diagnostics, func() (algorithm.Hash, error) {
+		return newHasher(data)
+	}, data.GetPassword().ValueString())
+	if digest == nil {
+		return
+	}
+	data.SetHash(types.StringValue(digest.String()))
+
+	tflog.Trace(ctx, "created a resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// readHashResource is the Read implementation shared by every password
+// hashing resource except Argon2: these resources have no upstream
+// service to refresh from, so Read just re-saves what is already in state.
+func readHashResource[T any, PT hashResourceModel[T]](ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data := PT(new(T))
+
+	resp.Diagnostics.Append(req.State.Get(ctx, data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// updateHashResource is the Update implementation shared by every
+// password hashing resource except Argon2: the hash is only recomputed
+// when password itself changed, otherwise the prior hash is carried over
+// so changing a Computed cost attribute's plan value alone doesn't rehash.
+func updateHashResource[T any, PT hashResourceModel[T]](ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse, newHasher func(PT) (algorithm.Hash, error)) {
+	data := PT(new(T))
+	oldData := PT(new(T))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(req.State.Get(ctx, oldData)...)
+
+	if oldData.GetPassword().Equal(data.GetPassword()) {
+		data.SetHash(oldData.GetHash())
+	} else {
+		digest := newDigest(&resp.Diagnostics, func() (algorithm.Hash, error) {
+			return newHasher(data)
+		}, data.GetPassword().ValueString())
+		if digest == nil {
+			return
+		}
+		data.SetHash(types.StringValue(digest.String()))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// deleteHashResource is the Delete implementation shared by every
+// password hashing resource except Argon2: there is no upstream resource
+// to tear down, so this only needs to read state back out for diagnostics.
+func deleteHashResource[T any, PT hashResourceModel[T]](ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	data := PT(new(T))
+
+	resp.Diagnostics.Append(req.State.Get(ctx, data)...)
+}
+
+// importStateByID is the ImportState implementation shared by every password
+// hashing resource in this provider, since every one of them keys its state
+// entirely off the synthetic "id" attribute.
+func importStateByID(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-crypt/crypt/algorithm"
+	"github.com/go-crypt/crypt/algorithm/pbkdf2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &Pbkdf2Resource{}
+	_ resource.ResourceWithImportState = &Pbkdf2Resource{}
+)
+
+func NewPbkdf2Resource() resource.Resource {
+	return &Pbkdf2Resource{}
+}
+
+// Pbkdf2Resource defines the resource implementation.
+type Pbkdf2Resource struct {
+	client *http.Client
+}
+
+// Pbkdf2ResourceModel describes the resource data model.
+type Pbkdf2ResourceModel struct {
+	Password   types.String `tfsdk:"password"`
+	Variant    types.String `tfsdk:"variant"`
+	Iterations types.Int32  `tfsdk:"iterations"`
+	Hash       types.String `tfsdk:"hash"`
+	Id         types.String `tfsdk:"id"`
+}
+
+func (m *Pbkdf2ResourceModel) GetPassword() types.String { return m.Password }
+func (m *Pbkdf2ResourceModel) GetHash() types.String     { return m.Hash }
+func (m *Pbkdf2ResourceModel) SetHash(hash types.String) { m.Hash = hash }
+func (m *Pbkdf2ResourceModel) SetId(id types.String)     { m.Id = id }
+
+func (r *Pbkdf2Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pbkdf2"
+}
+
+func (r *Pbkdf2Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "PBKDF2 resource",
+		Attributes: map[string]schema.Attribute{
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password to hash",
+				Required:            true,
+				Computed:            false,
+				Sensitive:           true,
+			},
+			"variant": schema.StringAttribute{
+				MarkdownDescription: "The pseudorandom function to use: one of `sha1`, `sha224`, `sha256`, `sha384`, `sha512`",
+				Default:             stringdefault.StaticString("sha512"),
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("sha1", "sha224", "sha256", "sha384", "sha512"),
+				},
+			},
+			"iterations": schema.Int32Attribute{
+				MarkdownDescription: "The number of hashing rounds",
+				Default:             int32default.StaticInt32(210000),
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"hash": schema.StringAttribute{
+				MarkdownDescription: "The generated hash",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "PBKDF2 identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *Pbkdf2Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureHashClient(req, resp)
+}
+
+// pbkdf2Variant maps the variant attribute onto the pbkdf2.Variant the
+// go-crypt hasher expects.
+func pbkdf2Variant(name string) (pbkdf2.Variant, error) {
+	switch name {
+	case "sha1":
+		return pbkdf2.VariantSHA1, nil
+	case "sha224":
+		return pbkdf2.VariantSHA224, nil
+	case "sha256":
+		return pbkdf2.VariantSHA256, nil
+	case "sha384":
+		return pbkdf2.VariantSHA384, nil
+	case "sha512":
+		return pbkdf2.VariantSHA512, nil
+	default:
+		return pbkdf2.VariantNone, fmt.Errorf("unsupported pbkdf2 variant %q", name)
+	}
+}
+
+func pbkdf2Hasher(data *Pbkdf2ResourceModel) (algorithm.Hash, error) {
+	variant, err := pbkdf2Variant(data.Variant.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	return pbkdf2.New(
+		pbkdf2.WithVariant(variant),
+		pbkdf2.WithIterations(int(data.Iterations.ValueInt32())),
+	)
+}
+
+func (r *Pbkdf2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	createHashResource[Pbkdf2ResourceModel](ctx, req, resp, "pbkdf2-id", pbkdf2Hasher)
+}
+
+func (r *Pbkdf2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readHashResource[Pbkdf2ResourceModel](ctx, req, resp)
+}
+
+func (r *Pbkdf2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	updateHashResource[Pbkdf2ResourceModel](ctx, req, resp, pbkdf2Hasher)
+}
+
+func (r *Pbkdf2Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	deleteHashResource[Pbkdf2ResourceModel](ctx, req, resp)
+}
+
+func (r *Pbkdf2Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateByID(ctx, req, resp)
+}
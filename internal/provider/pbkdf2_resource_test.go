@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPbkdf2ResourceCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPbkdf2ResourceConfig("example-password"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("password_pbkdf2.test", "password", "example-password"),
+					resource.TestCheckResourceAttr("password_pbkdf2.test", "id", "pbkdf2-id"),
+					resource.TestCheckResourceAttrSet("password_pbkdf2.test", "hash"),
+					resource.TestCheckResourceAttrWith("password_pbkdf2.test", "hash", validatePassword("example-password")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPbkdf2ResourceCreateAndUpdate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPbkdf2ResourceConfig("example-password"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("password_pbkdf2.test", "hash", validatePassword("example-password")),
+				),
+			},
+			{
+				Config: testAccPbkdf2ResourceConfig("new-password"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("password_pbkdf2.test", "hash", validatePassword("new-password")),
+				),
+			},
+		},
+	})
+}
+
+func testAccPbkdf2ResourceConfig(password string) string {
+	return fmt.Sprintf(`
+resource "password_pbkdf2" "test" {
+  password = %[1]q
+}
+`, password)
+}
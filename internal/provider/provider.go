@@ -8,6 +8,7 @@ import (
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -16,8 +17,9 @@ import (
 
 // Ensure ScaffoldingProvider satisfies various provider interfaces.
 var (
-	_ provider.Provider              = &PasswordProvider{}
-	_ provider.ProviderWithFunctions = &PasswordProvider{}
+	_ provider.Provider                       = &PasswordProvider{}
+	_ provider.ProviderWithFunctions          = &PasswordProvider{}
+	_ provider.ProviderWithEphemeralResources = &PasswordProvider{}
 )
 
 // PasswordProvider defines the provider implementation.
@@ -61,15 +63,31 @@ func (p *PasswordProvider) Configure(ctx context.Context, req provider.Configure
 func (p *PasswordProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewArgon2Resource,
+		NewBcryptResource,
+		NewScryptResource,
+		NewSha512cryptResource,
+		NewPbkdf2Resource,
 	}
 }
 
 func (p *PasswordProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewVerifyDataSource,
+	}
+}
+
+func (p *PasswordProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewArgon2EphemeralResource,
+	}
 }
 
 func (p *PasswordProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewArgon2IDFunction,
+		NewBcryptFunction,
+		NewVerifyFunction,
+	}
 }
 
 func New(version string) func() provider.Provider {
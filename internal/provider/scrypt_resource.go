@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-crypt/crypt/algorithm"
+	"github.com/go-crypt/crypt/algorithm/scrypt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ScryptResource{}
+	_ resource.ResourceWithImportState = &ScryptResource{}
+)
+
+func NewScryptResource() resource.Resource {
+	return &ScryptResource{}
+}
+
+// ScryptResource defines the resource implementation.
+type ScryptResource struct {
+	client *http.Client
+}
+
+// ScryptResourceModel describes the resource data model.
+type ScryptResourceModel struct {
+	Password types.String `tfsdk:"password"`
+	Ln       types.Int32  `tfsdk:"ln"`
+	R        types.Int32  `tfsdk:"r"`
+	P        types.Int32  `tfsdk:"p"`
+	Hash     types.String `tfsdk:"hash"`
+	Id       types.String `tfsdk:"id"`
+}
+
+func (m *ScryptResourceModel) GetPassword() types.String { return m.Password }
+func (m *ScryptResourceModel) GetHash() types.String     { return m.Hash }
+func (m *ScryptResourceModel) SetHash(hash types.String) { m.Hash = hash }
+func (m *ScryptResourceModel) SetId(id types.String)     { m.Id = id }
+
+func (r *ScryptResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scrypt"
+}
+
+func (r *ScryptResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Scrypt resource",
+		Attributes: map[string]schema.Attribute{
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password to hash",
+				Required:            true,
+				Computed:            false,
+				Sensitive:           true,
+			},
+			"ln": schema.Int32Attribute{
+				MarkdownDescription: "The log2 of the CPU/memory cost parameter",
+				Default:             int32default.StaticInt32(16),
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int32{
+					int32validator.Between(1, 58),
+				},
+			},
+			"r": schema.Int32Attribute{
+				MarkdownDescription: "The block size",
+				Default:             int32default.StaticInt32(8),
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"p": schema.Int32Attribute{
+				MarkdownDescription: "The parallelization factor",
+				Default:             int32default.StaticInt32(1),
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"hash": schema.StringAttribute{
+				MarkdownDescription: "The generated hash",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Scrypt identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ScryptResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureHashClient(req, resp)
+}
+
+func scryptHasher(data *ScryptResourceModel) (algorithm.Hash, error) {
+	return scrypt.New(
+		scrypt.WithLN(int(data.Ln.ValueInt32())),
+		scrypt.WithR(int(data.R.ValueInt32())),
+		scrypt.WithP(int(data.P.ValueInt32())),
+	)
+}
+
+func (r *ScryptResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	createHashResource[ScryptResourceModel](ctx, req, resp, "scrypt-id", scryptHasher)
+}
+
+func (r *ScryptResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readHashResource[ScryptResourceModel](ctx, req, resp)
+}
+
+func (r *ScryptResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	updateHashResource[ScryptResourceModel](ctx, req, resp, scryptHasher)
+}
+
+func (r *ScryptResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	deleteHashResource[ScryptResourceModel](ctx, req, resp)
+}
+
+func (r *ScryptResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateByID(ctx, req, resp)
+}
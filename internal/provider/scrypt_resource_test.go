@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccScryptResourceCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScryptResourceConfig("example-password"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("password_scrypt.test", "password", "example-password"),
+					resource.TestCheckResourceAttr("password_scrypt.test", "id", "scrypt-id"),
+					resource.TestCheckResourceAttrSet("password_scrypt.test", "hash"),
+					resource.TestCheckResourceAttrWith("password_scrypt.test", "hash", validatePassword("example-password")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccScryptResourceCreateAndUpdate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScryptResourceConfig("example-password"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("password_scrypt.test", "hash", validatePassword("example-password")),
+				),
+			},
+			{
+				Config: testAccScryptResourceConfig("new-password"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("password_scrypt.test", "hash", validatePassword("new-password")),
+				),
+			},
+		},
+	})
+}
+
+func testAccScryptResourceConfig(password string) string {
+	return fmt.Sprintf(`
+resource "password_scrypt" "test" {
+  password = %[1]q
+}
+`, password)
+}
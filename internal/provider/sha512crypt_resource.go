@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-crypt/crypt/algorithm"
+	"github.com/go-crypt/crypt/algorithm/shacrypt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &Sha512cryptResource{}
+	_ resource.ResourceWithImportState = &Sha512cryptResource{}
+)
+
+func NewSha512cryptResource() resource.Resource {
+	return &Sha512cryptResource{}
+}
+
+// Sha512cryptResource defines the resource implementation.
+type Sha512cryptResource struct {
+	client *http.Client
+}
+
+// Sha512cryptResourceModel describes the resource data model.
+type Sha512cryptResourceModel struct {
+	Password   types.String `tfsdk:"password"`
+	Iterations types.Int32  `tfsdk:"iterations"`
+	Hash       types.String `tfsdk:"hash"`
+	Id         types.String `tfsdk:"id"`
+}
+
+func (m *Sha512cryptResourceModel) GetPassword() types.String { return m.Password }
+func (m *Sha512cryptResourceModel) GetHash() types.String     { return m.Hash }
+func (m *Sha512cryptResourceModel) SetHash(hash types.String) { m.Hash = hash }
+func (m *Sha512cryptResourceModel) SetId(id types.String)     { m.Id = id }
+
+func (r *Sha512cryptResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sha512crypt"
+}
+
+func (r *Sha512cryptResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "SHA512crypt resource",
+		Attributes: map[string]schema.Attribute{
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password to hash",
+				Required:            true,
+				Computed:            false,
+				Sensitive:           true,
+			},
+			"iterations": schema.Int32Attribute{
+				MarkdownDescription: "The number of hashing rounds",
+				Default:             int32default.StaticInt32(5000),
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int32{
+					int32validator.Between(1000, 999999999),
+				},
+			},
+			"hash": schema.StringAttribute{
+				MarkdownDescription: "The generated hash",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "SHA512crypt identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *Sha512cryptResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureHashClient(req, resp)
+}
+
+func sha512cryptHasher(data *Sha512cryptResourceModel) (algorithm.Hash, error) {
+	return shacrypt.New(
+		shacrypt.WithVariant(shacrypt.VariantSHA512),
+		shacrypt.WithIterations(int(data.Iterations.ValueInt32())),
+	)
+}
+
+func (r *Sha512cryptResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	createHashResource[Sha512cryptResourceModel](ctx, req, resp, "sha512crypt-id", sha512cryptHasher)
+}
+
+func (r *Sha512cryptResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readHashResource[Sha512cryptResourceModel](ctx, req, resp)
+}
+
+func (r *Sha512cryptResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	updateHashResource[Sha512cryptResourceModel](ctx, req, resp, sha512cryptHasher)
+}
+
+func (r *Sha512cryptResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	deleteHashResource[Sha512cryptResourceModel](ctx, req, resp)
+}
+
+func (r *Sha512cryptResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateByID(ctx, req, resp)
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSha512cryptResourceCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSha512cryptResourceConfig("example-password"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("password_sha512crypt.test", "password", "example-password"),
+					resource.TestCheckResourceAttr("password_sha512crypt.test", "id", "sha512crypt-id"),
+					resource.TestCheckResourceAttrSet("password_sha512crypt.test", "hash"),
+					resource.TestCheckResourceAttrWith("password_sha512crypt.test", "hash", validatePassword("example-password")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSha512cryptResourceCreateAndUpdate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSha512cryptResourceConfig("example-password"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("password_sha512crypt.test", "hash", validatePassword("example-password")),
+				),
+			},
+			{
+				Config: testAccSha512cryptResourceConfig("new-password"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("password_sha512crypt.test", "hash", validatePassword("new-password")),
+				),
+			},
+		},
+	})
+}
+
+func testAccSha512cryptResourceConfig(password string) string {
+	return fmt.Sprintf(`
+resource "password_sha512crypt" "test" {
+  password = %[1]q
+}
+`, password)
+}
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-crypt/crypt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &VerifyDataSource{}
+
+func NewVerifyDataSource() datasource.DataSource {
+	return &VerifyDataSource{}
+}
+
+// VerifyDataSource checks a plaintext password against an existing
+// PHC-formatted hash, without re-hashing it.
+type VerifyDataSource struct{}
+
+// VerifyDataSourceModel describes the data source data model.
+type VerifyDataSourceModel struct {
+	Password   types.String `tfsdk:"password"`
+	Hash       types.String `tfsdk:"hash"`
+	Valid      types.Bool   `tfsdk:"valid"`
+	Algorithm  types.String `tfsdk:"algorithm"`
+	Variant    types.String `tfsdk:"variant"`
+	CostParams types.Map    `tfsdk:"cost_params"`
+}
+
+func (d *VerifyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_verify"
+}
+
+func (d *VerifyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Checks a plaintext password against an existing PHC-formatted hash, and parses the hash's algorithm, variant and cost parameters.",
+		Attributes: map[string]schema.Attribute{
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The plaintext password to check",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"hash": schema.StringAttribute{
+				MarkdownDescription: "The PHC-formatted hash to check the password against",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"valid": schema.BoolAttribute{
+				MarkdownDescription: "Whether `password` matches `hash`",
+				Computed:            true,
+			},
+			"algorithm": schema.StringAttribute{
+				MarkdownDescription: "The hashing algorithm identifier parsed from `hash`, e.g. `argon2id`, `2b`, `6`, `pbkdf2`",
+				Computed:            true,
+			},
+			"variant": schema.StringAttribute{
+				MarkdownDescription: "The algorithm variant parsed from `hash`, when its identifier encodes one (e.g. `sha512` for `pbkdf2-sha512`). Empty otherwise",
+				Computed:            true,
+			},
+			"cost_params": schema.MapAttribute{
+				MarkdownDescription: "The cost parameters encoded in `hash`, e.g. `m`, `t` and `p` for Argon2, `cost` for bcrypt, or `rounds` for sha512crypt",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *VerifyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VerifyDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	valid, err := crypt.CheckPassword(data.Password.ValueString(), data.Hash.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Verify error", fmt.Sprintf("Unable to verify password, got error: %s", err))
+		return
+	}
+	data.Valid = types.BoolValue(valid)
+
+	algorithmID, variant, params, err := decodePHC(data.Hash.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Verify error", fmt.Sprintf("Unable to decode hash, got error: %s", err))
+		return
+	}
+	data.Algorithm = types.StringValue(algorithmID)
+	data.Variant = types.StringValue(variant)
+
+	costParams, diags := types.MapValueFrom(ctx, types.StringType, params)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.CostParams = costParams
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// decodePHC splits a PHC-formatted hash ($id$params$salt$hash) into its
+// algorithm identifier, variant (the portion of id after a "-", if any) and
+// its cost parameters. It delegates to crypt.Decode first so that hash is
+// rejected the same way CheckPassword would reject it, rather than just
+// failing a naive "$"-prefix check.
+func decodePHC(hash string) (algorithmID string, variant string, params map[string]string, err error) {
+	if _, err := crypt.Decode(hash); err != nil {
+		return "", "", nil, err
+	}
+
+	segments := strings.Split(hash, "$")
+	if len(segments) < 4 {
+		return "", "", nil, fmt.Errorf("hash is not PHC-formatted")
+	}
+
+	id := segments[1]
+	algorithmID = id
+	if idx := strings.Index(id, "-"); idx != -1 {
+		algorithmID = id[:idx]
+		variant = id[idx+1:]
+	}
+
+	params = map[string]string{}
+
+	// bcrypt packs its bare cost factor where Argon2, scrypt and sha512crypt
+	// put a "key=value[,key=value...]" segment, and concatenates salt+hash
+	// into a single trailing segment instead of two, so it needs its own
+	// case rather than the generic "middle segments, last two are salt/hash"
+	// rule below.
+	if strings.HasPrefix(algorithmID, "2") {
+		params["cost"] = segments[2]
+		return algorithmID, variant, params, nil
+	}
+
+	// pbkdf2 likewise stores a bare iteration count, with no "i=" key, in the
+	// segment after its id; it is reported under "i".
+	if algorithmID == "pbkdf2" {
+		params["i"] = segments[2]
+		return algorithmID, variant, params, nil
+	}
+
+	// Every segment between the id and the trailing salt/hash pair holds
+	// cost parameters: a lone "v=19" version marker plus "m=...,t=...,p=..."
+	// for Argon2, "rounds=..." for sha512crypt (absent entirely when the
+	// algorithm default is used), or "ln=...,r=...,p=..." for scrypt.
+	for _, segment := range segments[2 : len(segments)-2] {
+		for _, pair := range strings.Split(segment, ",") {
+			kv := strings.SplitN(pair, "=", 2)
+			if len(kv) == 2 {
+				params[kv[0]] = kv[1]
+			}
+		}
+	}
+
+	return algorithmID, variant, params, nil
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVerifyDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVerifyDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.password_verify.argon2", "valid", "true"),
+					resource.TestCheckResourceAttr("data.password_verify.argon2", "algorithm", "argon2id"),
+					resource.TestCheckResourceAttr("data.password_verify.argon2", "cost_params.m", "65536"),
+					resource.TestCheckResourceAttr("data.password_verify.argon2", "cost_params.t", "1"),
+					resource.TestCheckResourceAttrSet("data.password_verify.argon2", "cost_params.p"),
+					resource.TestCheckResourceAttr("data.password_verify.bcrypt", "valid", "true"),
+					resource.TestCheckResourceAttr("data.password_verify.bcrypt", "cost_params.cost", "10"),
+					resource.TestCheckResourceAttr("data.password_verify.scrypt", "valid", "true"),
+					resource.TestCheckResourceAttr("data.password_verify.scrypt", "cost_params.ln", "16"),
+					resource.TestCheckResourceAttr("data.password_verify.scrypt", "cost_params.r", "8"),
+					resource.TestCheckResourceAttr("data.password_verify.scrypt", "cost_params.p", "1"),
+					resource.TestCheckResourceAttr("data.password_verify.sha512crypt", "valid", "true"),
+					resource.TestCheckNoResourceAttr("data.password_verify.sha512crypt", "cost_params.rounds"),
+					resource.TestCheckResourceAttr("data.password_verify.pbkdf2", "valid", "true"),
+					resource.TestCheckResourceAttr("data.password_verify.pbkdf2", "variant", "sha512"),
+					resource.TestCheckResourceAttr("data.password_verify.pbkdf2", "cost_params.i", "210000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVerifyDataSourceConfig() string {
+	return `
+resource "password_argon2" "test" {
+  password            = "example-password"
+  salt                = "example-salt"
+  password_wo_version = 1
+}
+
+resource "password_bcrypt" "test" {
+  password = "example-password"
+}
+
+resource "password_scrypt" "test" {
+  password = "example-password"
+}
+
+resource "password_sha512crypt" "test" {
+  password = "example-password"
+}
+
+resource "password_pbkdf2" "test" {
+  password = "example-password"
+}
+
+data "password_verify" "argon2" {
+  password = "example-password"
+  hash     = password_argon2.test.hash
+}
+
+data "password_verify" "bcrypt" {
+  password = "example-password"
+  hash     = password_bcrypt.test.hash
+}
+
+data "password_verify" "scrypt" {
+  password = "example-password"
+  hash     = password_scrypt.test.hash
+}
+
+data "password_verify" "sha512crypt" {
+  password = "example-password"
+  hash     = password_sha512crypt.test.hash
+}
+
+data "password_verify" "pbkdf2" {
+  password = "example-password"
+  hash     = password_pbkdf2.test.hash
+}
+`
+}